@@ -1,13 +1,24 @@
 package main
 
 import (
+	"context"
+	"log"
+
 	providers "github.com/raywall/terraform-provider-raysouz/providers/raysouz"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: providers.Provider,
-	})
+	ctx := context.Background()
+
+	providerServer, err := providers.ProviderServer(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = tf6server.Serve("registry.terraform.io/raywall/raysouz", providerServer)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
@@ -1,13 +1,14 @@
 package main
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	providers "github.com/raywall/terraform-provider-raysouz/providers/raysouz"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func readTestConfigFile(t *testing.T, filename string) string {
@@ -18,6 +19,32 @@ func readTestConfigFile(t *testing.T, filename string) string {
 	return string(content)
 }
 
+func TestProviderServer(t *testing.T) {
+	ctx := context.Background()
+
+	providerServer, err := providers.ProviderServer(ctx)
+	if err != nil {
+		t.Fatalf("Erro ao criar o servidor do provedor: %v", err)
+	}
+
+	// O mux só compara os schemas do SDKv2 e do framework no GetProviderSchema,
+	// retornando diagnósticos de erro se eles divergirem
+	resp, err := providerServer().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("Erro ao obter o schema do provedor: %v", err)
+	}
+
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Errorf("Diagnóstico de erro no schema do provedor: %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+
+	if _, ok := resp.ResourceSchemas["raysouz_custom_resource"]; !ok {
+		t.Error("Recurso raysouz_custom_resource não encontrado no schema do provedor")
+	}
+}
+
 func TestCustomProviderIntegration(t *testing.T) {
 	// Definir o arquivo de configuração Terraform a ser utilizado
 	tfConfig := readTestConfigFile(t, `testdata/main.tf`)
@@ -27,14 +54,20 @@ func TestCustomProviderIntegration(t *testing.T) {
 		// Adicionar verificações conforme necessário
 	}
 
-	// Definir os provedores a serem utilizados no teste
-	testProviders := map[string]*schema.Provider{
-		"terraform-provider-raysouz": providers.Provider(),
+	// Definir os provedores a serem utilizados no teste (SDKv2 + framework via mux)
+	testProviders := map[string]func() (tfprotov6.ProviderServer, error){
+		"raysouz": func() (tfprotov6.ProviderServer, error) {
+			providerServer, err := providers.ProviderServer(context.Background())
+			if err != nil {
+				return nil, err
+			}
+			return providerServer(), nil
+		},
 	}
 
 	// Executar o teste de integração
 	resource.Test(t, resource.TestCase{
-		Providers: testProviders,
+		ProtoV6ProviderFactories: testProviders,
 		Steps: []resource.TestStep{
 			{
 				Config: tfConfig,